@@ -0,0 +1,31 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import "strings"
+
+// baseStruct is the interface implemented by every generatable wrapper
+// description (a single message, or a slice of messages). Each
+// implementation knows how to emit its own Go source and the matching test
+// source into the shared builders.
+type baseStruct interface {
+	// generateStruct emits the struct type, its constructors and its
+	// accessor methods.
+	generateStruct(sb *strings.Builder)
+
+	// generateTests emits the *_test.go content exercising the generated
+	// accessors.
+	generateTests(sb *strings.Builder)
+}
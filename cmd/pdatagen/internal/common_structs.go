@@ -0,0 +1,80 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+// instrumentationLibrary describes internal/data's InstrumentationLibrary,
+// a thin wrapper over a single *otlpcommon.InstrumentationLibrary.
+var instrumentationLibrary = &messageStruct{
+	structName:     "InstrumentationLibrary",
+	description:    "InstrumentationLibrary is a message representing the instrumentation library information.",
+	originFullName: "otlpcommon.InstrumentationLibrary",
+	fields: []field{
+		&primitiveField{fieldName: "Name", goType: "string", testValue: `"test_name"`},
+		&primitiveField{fieldName: "Version", goType: "string", testValue: `"test_version"`},
+	},
+}
+
+// stringKeyValue describes internal/data's StringKeyValue, the element type
+// stored in a StringMap.
+var stringKeyValue = &messageStruct{
+	structName:     "StringKeyValue",
+	description:    "StringKeyValue stores a key and value pair.",
+	originFullName: "otlpcommon.StringKeyValue",
+	fields: []field{
+		&primitiveField{fieldName: "Key", goType: "string", testValue: `"test_key"`},
+		&primitiveField{fieldName: "Value", goType: "string", testValue: `"test_value"`},
+	},
+}
+
+// stringMap describes internal/data's StringMap, a slice-backed map of
+// string keys to string values.
+var stringMap = &sliceStruct{
+	structName:      "StringMap",
+	description:     "StringMap stores a map of attribute keys to values.",
+	elementFullName: "otlpcommon.StringKeyValue",
+	elementTypeName: "StringKeyValue",
+}
+
+// attributesMap describes internal/data's AttributesMap, a slice-backed map
+// of string keys to the hand-maintained AttributeValue type, analogous to
+// stringMap but holding AttributeValue instead of a generated element type.
+var attributesMap = &sliceStruct{
+	structName:      "AttributesMap",
+	description:     "AttributesMap stores a map of attribute keys to values.",
+	elementFullName: "otlpcommon.AttributeKeyValue",
+	elementTypeName: "AttributeValue",
+}
+
+// commonFile is the declarative description of everything pdatagen can
+// currently regenerate in internal/data/common.go. As the log, metric and
+// trace pdata packages grow their own slice wrappers, add sibling *_structs.go
+// files here and list their Files in AllFiles.
+var commonFile = &File{
+	Name:             "common",
+	PackageDir:       "internal/data",
+	PackageName:      "data",
+	ImportOtlpCommon: true,
+	Structs: []baseStruct{
+		instrumentationLibrary,
+		stringKeyValue,
+		stringMap,
+		attributesMap,
+	},
+}
+
+// AllFiles lists every generated file this command maintains.
+var AllFiles = []*File{
+	commonFile,
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// field describes one accessor pair (<Name>/Set<Name>) generated onto a
+// messageStruct for a single scalar field of the wrapped OTLP message.
+type field interface {
+	generateAccessors(msgName string, sb *strings.Builder)
+	generateAccessorsTest(msgName string, sb *strings.Builder)
+	generateCopyToLine(destName string, sb *strings.Builder)
+}
+
+// primitiveField is a field whose Go type requires no wrapping, e.g. string,
+// bool, int64, float64.
+type primitiveField struct {
+	// fieldName is both the exported accessor name and the field name on
+	// the underlying otlp struct, e.g. "Name" generates Name()/SetName()
+	// backed by orig.Name.
+	fieldName string
+	goType    string
+	testValue string
+}
+
+func (f *primitiveField) generateAccessors(msgName string, sb *strings.Builder) {
+	fmt.Fprintf(sb, "func (ms %s) %s() %s {\n\treturn ms.orig.%s\n}\n\n", msgName, f.fieldName, f.goType, f.fieldName)
+	fmt.Fprintf(sb, "func (ms %s) Set%s(v %s) {\n\tms.orig.%s = v\n}\n\n", msgName, f.fieldName, f.goType, f.fieldName)
+}
+
+func (f *primitiveField) generateCopyToLine(destName string, sb *strings.Builder) {
+	fmt.Fprintf(sb, "\t%s.Set%s(ms.%s())\n", destName, f.fieldName, f.fieldName)
+}
+
+func (f *primitiveField) generateAccessorsTest(msgName string, sb *strings.Builder) {
+	fmt.Fprintf(sb, "func Test%s_%s(t *testing.T) {\n", msgName, f.fieldName)
+	fmt.Fprintf(sb, "\tms := New%s()\n", msgName)
+	fmt.Fprintf(sb, "\tms.Set%s(%s)\n", f.fieldName, f.testValue)
+	fmt.Fprintf(sb, "\tassert.EqualValues(t, %s, ms.%s())\n}\n\n", f.testValue, f.fieldName)
+}
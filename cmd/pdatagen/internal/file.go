@@ -0,0 +1,93 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"strings"
+)
+
+const generatedHeader = `// Code generated by "cmd/pdatagen"; DO NOT EDIT.
+// To regenerate this file run "go run ./cmd/pdatagen" from the repository root.
+
+`
+
+// File describes one generated_<name>.go / generated_<name>_test.go pair.
+type File struct {
+	// Name is used as the base of the generated file names, e.g. "common"
+	// produces generated_common.go and generated_common_test.go.
+	Name string
+
+	// PackageDir is the directory the generated files are written to,
+	// relative to the repository root, e.g. "internal/data".
+	PackageDir string
+
+	// PackageName is the Go package name declared by the generated files.
+	PackageName string
+
+	ImportOtlpCommon bool
+
+	// Structs are the wrapper descriptions to emit, in declaration order.
+	Structs []baseStruct
+}
+
+// Generate writes the struct and test files described by f.
+func (f *File) Generate() error {
+	if err := f.writeFile(f.generateStructFile(), f.Name+".go"); err != nil {
+		return err
+	}
+	return f.writeFile(f.generateTestFile(), f.Name+"_test.go")
+}
+
+func (f *File) generateStructFile() string {
+	var sb strings.Builder
+	sb.WriteString(generatedHeader)
+	fmt.Fprintf(&sb, "package %s\n\n", f.PackageName)
+	if f.ImportOtlpCommon {
+		sb.WriteString("import otlpcommon \"github.com/open-telemetry/opentelemetry-proto/gen/go/common/v1\"\n\n")
+	}
+	for _, s := range f.Structs {
+		s.generateStruct(&sb)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (f *File) generateTestFile() string {
+	var sb strings.Builder
+	sb.WriteString(generatedHeader)
+	fmt.Fprintf(&sb, "package %s\n\n", f.PackageName)
+	sb.WriteString("import (\n\t\"testing\"\n\n\t\"github.com/stretchr/testify/assert\"\n)\n\n")
+	for _, s := range f.Structs {
+		s.generateTests(&sb)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (f *File) writeFile(content, suffix string) error {
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		// Still write the unformatted source so the error is easy to diagnose.
+		formatted = []byte(content)
+	}
+	path := f.PackageDir + "/generated_" + suffix
+	if err := ioutil.WriteFile(path, formatted, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
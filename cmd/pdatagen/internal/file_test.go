@@ -0,0 +1,38 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAllFilesGenerateParsableSource checks that every File in AllFiles
+// produces a struct file and a test file that parse. The output is not
+// type-checked, so references to undefined types or functions are not caught.
+func TestAllFilesGenerateParsableSource(t *testing.T) {
+	for _, f := range AllFiles {
+		fset := token.NewFileSet()
+
+		_, err := parser.ParseFile(fset, f.Name+".go", f.generateStructFile(), parser.AllErrors)
+		assert.NoError(t, err, "struct file for %q failed to parse", f.Name)
+
+		_, err = parser.ParseFile(fset, f.Name+"_test.go", f.generateTestFile(), parser.AllErrors)
+		assert.NoError(t, err, "test file for %q failed to parse", f.Name)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// messageStruct describes a wrapper around a single pointer to an OTLP
+// message, e.g. InstrumentationLibrary. It generates New<Name>/new<name>
+// constructors and one accessor pair per field.
+type messageStruct struct {
+	structName     string
+	description    string
+	originFullName string
+	fields         []field
+}
+
+func (ms *messageStruct) generateStruct(sb *strings.Builder) {
+	fmt.Fprintf(sb, "// %s\n", ms.description)
+	fmt.Fprintf(sb, "//\n// Must use New%s function to create new instances.\n", ms.structName)
+	fmt.Fprintf(sb, "// Important: zero-initialized instance is not valid for use.\n")
+	fmt.Fprintf(sb, "type %s struct {\n\torig *%s\n}\n\n", ms.structName, ms.originFullName)
+
+	fmt.Fprintf(sb, "// New%s creates a new empty %s.\n", ms.structName, ms.structName)
+	fmt.Fprintf(sb, "func New%s() %s {\n\treturn %s{orig: &%s{}}\n}\n\n", ms.structName, ms.structName, ms.structName, ms.originFullName)
+	fmt.Fprintf(sb, "func new%s(orig *%s) %s {\n\treturn %s{orig: orig}\n}\n\n", ms.structName, ms.originFullName, ms.structName, ms.structName)
+
+	for _, f := range ms.fields {
+		f.generateAccessors(ms.structName, sb)
+	}
+
+	fmt.Fprintf(sb, "// CopyTo copies all properties from the current %s to dest.\n", ms.structName)
+	fmt.Fprintf(sb, "func (ms %s) CopyTo(dest %s) {\n", ms.structName, ms.structName)
+	for _, f := range ms.fields {
+		f.generateCopyToLine("dest", sb)
+	}
+	sb.WriteString("}\n\n")
+}
+
+func (ms *messageStruct) generateTests(sb *strings.Builder) {
+	fmt.Fprintf(sb, "func TestNew%s(t *testing.T) {\n\tassert.NotNil(t, New%s())\n}\n\n", ms.structName, ms.structName)
+	for _, f := range ms.fields {
+		f.generateAccessorsTest(ms.structName, sb)
+	}
+}
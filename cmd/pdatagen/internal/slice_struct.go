@@ -0,0 +1,91 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sliceStruct describes a wrapper around a *[]<elementFullName>, e.g.
+// StringMap wrapping *[]otlpcommon.StringKeyValue. It generates the uniform
+// New/Len/At/Append/Resize/MoveTo/CopyTo method set shared by every generated
+// slice wrapper in internal/data.
+type sliceStruct struct {
+	structName      string
+	description     string
+	elementFullName string // e.g. "otlpcommon.StringKeyValue"
+	elementTypeName string // e.g. "StringKeyValue", the wrapper returned by At
+}
+
+func (ss *sliceStruct) generateStruct(sb *strings.Builder) {
+	s, e, w := ss.structName, ss.elementFullName, ss.elementTypeName
+
+	fmt.Fprintf(sb, "// %s\n", ss.description)
+	fmt.Fprintf(sb, "//\n// Must use New%s function to create new instances.\n", s)
+	fmt.Fprintf(sb, "// Important: zero-initialized instance is not valid for use.\n")
+	fmt.Fprintf(sb, "type %s struct {\n\torig *[]%s\n}\n\n", s, e)
+
+	fmt.Fprintf(sb, "// New%s creates a %s with 0 elements.\n", s, s)
+	fmt.Fprintf(sb, "func New%s() %s {\n\torig := []%s(nil)\n\treturn %s{&orig}\n}\n\n", s, s, e, s)
+	fmt.Fprintf(sb, "func new%s(orig *[]%s) %s {\n\treturn %s{orig}\n}\n\n", s, e, s, s)
+
+	fmt.Fprintf(sb, "// Len returns the number of elements in the %s.\n", s)
+	fmt.Fprintf(sb, "func (es %s) Len() int {\n\treturn len(*es.orig)\n}\n\n", s)
+
+	fmt.Fprintf(sb, "// At returns the element at the given index.\n")
+	fmt.Fprintf(sb, "//\n// This function is used mostly for iterating over all the elements:\n")
+	fmt.Fprintf(sb, "// for i := 0; i < es.Len(); i++ {\n//     e := es.At(i)\n//     ... // Do something with the element\n// }\n")
+	fmt.Fprintf(sb, "func (es %s) At(ix int) %s {\n\treturn new%s(&(*es.orig)[ix])\n}\n\n", s, w, w)
+
+	fmt.Fprintf(sb, "// Append adds the given element to the end of the %s.\n", s)
+	fmt.Fprintf(sb, "func (es %s) Append(e %s) {\n\t*es.orig = append(*es.orig, *e.orig)\n}\n\n", s, w)
+
+	fmt.Fprintf(sb, "// Resize shrinks or grows the %s to the given newLen, preserving\n// the existing elements.\n", s)
+	fmt.Fprintf(sb, "func (es %s) Resize(newLen int) {\n", s)
+	fmt.Fprintf(sb, "\tif newLen <= len(*es.orig) {\n\t\t*es.orig = (*es.orig)[:newLen]\n\t\treturn\n\t}\n")
+	fmt.Fprintf(sb, "\t*es.orig = append(*es.orig, make([]%s, newLen-len(*es.orig))...)\n}\n\n", e)
+
+	fmt.Fprintf(sb, "// MoveTo moves all elements from the current %s to dest, overriding\n// the existing elements in dest, and resets the current %s to be empty.\n", s, s)
+	fmt.Fprintf(sb, "func (es %s) MoveTo(dest %s) {\n\t*dest.orig = *es.orig\n\t*es.orig = nil\n}\n\n", s, s)
+
+	fmt.Fprintf(sb, "// CopyTo copies all elements from the current %s to dest,\n// overriding the existing elements in dest.\n", s)
+	fmt.Fprintf(sb, "func (es %s) CopyTo(dest %s) {\n", s, s)
+	fmt.Fprintf(sb, "\tdestOrig := *dest.orig\n\tif len(*es.orig) <= cap(destOrig) {\n")
+	fmt.Fprintf(sb, "\t\tdestOrig = destOrig[:len(*es.orig)]\n\t\tfor i := range *es.orig {\n\t\t\tnew%s(&(*es.orig)[i]).CopyTo(new%s(&destOrig[i]))\n\t\t}\n", w, w)
+	fmt.Fprintf(sb, "\t\t*dest.orig = destOrig\n\t\treturn\n\t}\n")
+	fmt.Fprintf(sb, "\torigs := make([]%s, len(*es.orig))\n\twrappers := make([]%s, len(*es.orig))\n", e, w)
+	fmt.Fprintf(sb, "\tfor i := range *es.orig {\n\t\twrappers[i] = new%s(&origs[i])\n\t\tnew%s(&(*es.orig)[i]).CopyTo(wrappers[i])\n\t}\n", w, w)
+	fmt.Fprintf(sb, "\t*dest.orig = origs\n}\n\n")
+}
+
+func (ss *sliceStruct) generateTests(sb *strings.Builder) {
+	s := ss.structName
+	fmt.Fprintf(sb, "func TestNew%s(t *testing.T) {\n\tes := New%s()\n\tassert.EqualValues(t, 0, es.Len())\n}\n\n", s, s)
+	fmt.Fprintf(sb, "func Test%s_Resize(t *testing.T) {\n", s)
+	fmt.Fprintf(sb, "\tes := New%s()\n\tes.Resize(7)\n\tassert.EqualValues(t, 7, es.Len())\n}\n\n", s)
+
+	// Give the two elements distinguishable content before CopyTo/MoveTo so
+	// a regression that copies the wrong direction, or drops/duplicates an
+	// element, shows up as a content mismatch rather than only a Len() one.
+	fmt.Fprintf(sb, "func Test%s_MoveAndCopy(t *testing.T) {\n", s)
+	fmt.Fprintf(sb, "\tes := New%s()\n\tes.Resize(2)\n", s)
+	fmt.Fprintf(sb, "\tes.At(0).SetKey(\"test_key_0\")\n\tes.At(1).SetKey(\"test_key_1\")\n")
+	fmt.Fprintf(sb, "\tdest := New%s()\n\tes.CopyTo(dest)\n", s)
+	fmt.Fprintf(sb, "\tassert.EqualValues(t, es.Len(), dest.Len())\n")
+	fmt.Fprintf(sb, "\tassert.EqualValues(t, \"test_key_0\", dest.At(0).Key())\n")
+	fmt.Fprintf(sb, "\tassert.EqualValues(t, \"test_key_1\", dest.At(1).Key())\n")
+	fmt.Fprintf(sb, "\tes.MoveTo(dest)\n\tassert.EqualValues(t, 0, es.Len())\n}\n\n")
+}
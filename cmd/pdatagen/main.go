@@ -0,0 +1,46 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command pdatagen generates the repetitive wrapper types in internal/data
+// (StringMap, StringKeyValue, AttributesMap and InstrumentationLibrary) from a
+// small declarative description of each OTLP message, defined in the
+// *_structs.go files of cmd/pdatagen/internal. It does not generate the span,
+// metric or log slice types.
+//
+// Run it from the repository root with:
+//
+//	go run ./cmd/pdatagen
+//
+// Each generated file is written next to its package as generated_<name>.go
+// and generated_<name>_test.go, and carries a "Code generated ... DO NOT
+// EDIT." header. Hand edits to a generated file will be lost the next time
+// this command runs; change the struct/field descriptions in
+// cmd/pdatagen/internal instead.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/pdatagen/internal"
+)
+
+func main() {
+	for _, fs := range internal.AllFiles {
+		if err := fs.Generate(); err != nil {
+			fmt.Fprintf(os.Stderr, "pdatagen: %s: %v\n", fs.Name, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -16,10 +16,14 @@ package data
 
 // This file contains data structures that are common for all telemetry types,
 // such as timestamps, attributes, etc.
+//
+// StringMap, StringKeyValue, InstrumentationLibrary and AttributesMap are
+// generated by cmd/pdatagen into generated_common.go; non-generated
+// extensions to those types (Clone/Equal and the Get/Delete/
+// Insert/Upsert/Sort map-like methods on StringMap and AttributesMap)
+// live in common_extra.go instead of here; their CopyTo is generated.
 
 import (
-	"sort"
-
 	otlpcommon "github.com/open-telemetry/opentelemetry-proto/gen/go/common/v1"
 )
 
@@ -36,8 +40,29 @@ const (
 	AttributeValueINT    AttributeValueType = AttributeValueType(otlpcommon.AttributeKeyValue_INT)
 	AttributeValueDOUBLE AttributeValueType = AttributeValueType(otlpcommon.AttributeKeyValue_DOUBLE)
 	AttributeValueBOOL   AttributeValueType = AttributeValueType(otlpcommon.AttributeKeyValue_BOOL)
+	AttributeValueMAP    AttributeValueType = AttributeValueType(otlpcommon.AttributeKeyValue_MAP)
+	AttributeValueARRAY  AttributeValueType = AttributeValueType(otlpcommon.AttributeKeyValue_ARRAY)
 )
 
+// String returns the string representation of the AttributeValueType.
+func (t AttributeValueType) String() string {
+	switch t {
+	case AttributeValueSTRING:
+		return "STRING"
+	case AttributeValueINT:
+		return "INT"
+	case AttributeValueDOUBLE:
+		return "DOUBLE"
+	case AttributeValueBOOL:
+		return "BOOL"
+	case AttributeValueMAP:
+		return "MAP"
+	case AttributeValueARRAY:
+		return "ARRAY"
+	}
+	return ""
+}
+
 // AttributeValue represents a value of an attribute. Typically used in an Attributes map.
 // Must use one of NewAttributeValue* functions below to create new instances.
 // Important: zero-initialized instance is not valid for use.
@@ -56,6 +81,10 @@ type AttributeValue struct {
 	orig *otlpcommon.AttributeKeyValue
 }
 
+func newAttributeValue(orig *otlpcommon.AttributeKeyValue) AttributeValue {
+	return AttributeValue{orig: orig}
+}
+
 func NewAttributeValueString(v string) AttributeValue {
 	return AttributeValue{orig: &otlpcommon.AttributeKeyValue{Type: otlpcommon.AttributeKeyValue_STRING, StringValue: v}}
 }
@@ -72,10 +101,21 @@ func NewAttributeValueBool(v bool) AttributeValue {
 	return AttributeValue{orig: &otlpcommon.AttributeKeyValue{Type: otlpcommon.AttributeKeyValue_BOOL, BoolValue: v}}
 }
 
+// NewAttributeValueMap creates a new AttributeValue holding a nested AttributesMap.
+func NewAttributeValueMap(v AttributesMap) AttributeValue {
+	return AttributeValue{orig: &otlpcommon.AttributeKeyValue{Type: otlpcommon.AttributeKeyValue_MAP, MapValue: *v.orig}}
+}
+
+// NewAttributeValueArray creates a new AttributeValue holding an ordered AnyValueArray.
+func NewAttributeValueArray(v AnyValueArray) AttributeValue {
+	return AttributeValue{orig: &otlpcommon.AttributeKeyValue{Type: otlpcommon.AttributeKeyValue_ARRAY, ArrayValue: *v.orig}}
+}
+
 // NewAttributeValueSlice creates a slice of attributes values that are correctly initialized.
 func NewAttributeValueSlice(len int) []AttributeValue {
-	// Allocate 2 slices, one for AttributeValues, another for underlying OTLP structs.
-	// TODO: make one allocation for both slices.
+	// Allocate the underlying OTLP structs as a single contiguous slice and
+	// hand out one thin AttributeValue wrapper per entry, the same split
+	// allocation used by every other slice-backed wrapper in this package.
 	origs := make([]otlpcommon.AttributeKeyValue, len)
 	wrappers := make([]AttributeValue, len)
 	for i := range origs {
@@ -88,6 +128,19 @@ func NewAttributeValueSlice(len int) []AttributeValue {
 // via NewAttributeValue* functions. Calling these functions on zero-initialized
 // AttributeValue struct will cause a panic.
 
+// Key returns the key stored with this AttributeValue. Elements of an
+// AttributesMap carry their map key here; CopyTo and Clone copy it, and
+// AttributesMap.Insert sets it on the value passed in. A value that was
+// never given a key returns "".
+func (a AttributeValue) Key() string {
+	return a.orig.Key
+}
+
+// SetKey replaces the key associated with this AttributeValue.
+func (a AttributeValue) SetKey(k string) {
+	a.orig.Key = k
+}
+
 func (a AttributeValue) Type() AttributeValueType {
 	return AttributeValueType(a.orig.Type)
 }
@@ -108,6 +161,25 @@ func (a AttributeValue) BoolVal() bool {
 	return a.orig.BoolValue
 }
 
+// MapVal returns the nested AttributesMap value associated with this AttributeValue,
+// as a view over the same backing slice: inserting, deleting or mutating
+// through the returned AttributesMap writes back into a.
+// When Type() is not AttributeValueMAP this returns the map value still stored
+// in a, which is empty unless a held a map before being set to another type.
+func (a AttributeValue) MapVal() AttributesMap {
+	return newAttributesMap(&a.orig.MapValue)
+}
+
+// ArrayVal returns the AnyValueArray value associated with this AttributeValue,
+// as a view over the same backing slice: appending or mutating through the
+// returned AnyValueArray writes back into a.
+// When Type() is not AttributeValueARRAY this returns the array value still
+// stored in a, which is empty unless a held an array before being set to
+// another type.
+func (a AttributeValue) ArrayVal() AnyValueArray {
+	return newAnyValueArray(&a.orig.ArrayValue)
+}
+
 func (a AttributeValue) SetString(v string) {
 	a.orig.Type = otlpcommon.AttributeKeyValue_STRING
 	a.orig.StringValue = v
@@ -128,178 +200,105 @@ func (a AttributeValue) SetBool(v bool) {
 	a.orig.BoolValue = v
 }
 
-// AttributesMap stores a map of attribute keys to values.
-type AttributesMap map[string]AttributeValue
-
-// Attributes stores the map of attributes and a number of dropped attributes.
-// Typically used by translator functions to easily pass the pair.
-type Attributes struct {
-	attrs        AttributesMap
-	droppedCount uint32
-}
-
-func NewAttributes(m AttributesMap, droppedCount uint32) Attributes {
-	return Attributes{m, droppedCount}
-}
-
-// StringKeyValue stores a key and value pair.
-type StringKeyValue struct {
-	orig *otlpcommon.StringKeyValue
-}
-
-// NewStringKeyValue creates a new StringKeyValue with the given key and value.
-func NewStringKeyValue(k string, v string) StringKeyValue {
-	return StringKeyValue{&otlpcommon.StringKeyValue{Key: k, Value: v}}
-}
-
-// Key returns the key associated with this StringKeyValue.
-func (akv StringKeyValue) Key() string {
-	return akv.orig.Key
-}
-
-// Value returns the value associated with this StringKeyValue.
-func (akv StringKeyValue) Value() string {
-	return akv.orig.Value
+// SetMapVal replaces the nested map held by this AttributeValue with the given AttributesMap.
+func (a AttributeValue) SetMapVal(v AttributesMap) {
+	a.orig.Type = otlpcommon.AttributeKeyValue_MAP
+	a.orig.MapValue = *v.orig
 }
 
-// SetValue replaces the value associated with this StringKeyValue.
-func (akv StringKeyValue) SetValue(v string) {
-	akv.orig.Value = v
+// SetArrayVal replaces the array held by this AttributeValue with the given AnyValueArray.
+func (a AttributeValue) SetArrayVal(v AnyValueArray) {
+	a.orig.Type = otlpcommon.AttributeKeyValue_ARRAY
+	a.orig.ArrayValue = *v.orig
 }
 
-// StringMap stores a map of attribute keys to values.
-type StringMap struct {
-	orig *[]*otlpcommon.StringKeyValue
-}
-
-func newStringMap(orig *[]*otlpcommon.StringKeyValue) StringMap {
-	return StringMap{orig}
-}
-
-// NewStringMap creates a new StringMap from the given map[string]string.
-func NewStringMap(attrMap map[string]string) StringMap {
-	if len(attrMap) == 0 {
-		var orig []*otlpcommon.StringKeyValue
-		return StringMap{&orig}
-	}
-	origs := make([]otlpcommon.StringKeyValue, len(attrMap))
-	wrappers := make([]*otlpcommon.StringKeyValue, len(attrMap))
-
-	ix := 0
-	for k, v := range attrMap {
-		wrappers[ix] = &origs[ix]
-		wrappers[ix].Key = k
-		wrappers[ix].Value = v
-		ix++
-	}
-
-	return StringMap{&wrappers}
-}
-
-// Get returns the StringKeyValue associated with the key and true,
-// otherwise an invalid instance of the StringKeyValue and false.
-func (sm StringMap) Get(k string) (StringKeyValue, bool) {
-	for _, a := range *sm.orig {
-		if a.Key == k {
-			return StringKeyValue{a}, true
+// CopyTo copies this AttributeValue, including any nested MAP or ARRAY value,
+// into dest, overwriting whatever dest previously held.
+func (a AttributeValue) CopyTo(dest AttributeValue) {
+	*dest.orig = *a.orig
+	switch a.Type() {
+	case AttributeValueMAP:
+		dest.SetMapVal(a.MapVal().Clone())
+	case AttributeValueARRAY:
+		src := a.ArrayVal()
+		elems := make([]AttributeValue, src.Len())
+		for i := 0; i < src.Len(); i++ {
+			elems[i] = src.Get(i).Clone()
 		}
+		dest.SetArrayVal(NewAnyValueArray(elems))
 	}
-	return StringKeyValue{nil}, false
 }
 
-// Delete deletes the entry associated with the key and returns true if the key
-// was present in the map, otherwise returns false.
-func (sm StringMap) Delete(k string) bool {
-	for i, a := range *sm.orig {
-		if a.Key == k {
-			(*sm.orig)[i] = (*sm.orig)[len(*sm.orig)-1]
-			*sm.orig = (*sm.orig)[:len(*sm.orig)-1]
-			return true
-		}
-	}
-	return false
+// Clone returns an independent deep copy of a, safe to mutate without
+// affecting a or anything a shares storage with.
+func (a AttributeValue) Clone() AttributeValue {
+	dest := NewAttributeValueString("")
+	a.CopyTo(dest)
+	return dest
 }
 
-// Insert adds the StringKeyValue to the map when the key does not exist.
-// No action is applied to the map where the key already exists.
-func (sm StringMap) Insert(k, v string) {
-	if _, existing := sm.Get(k); !existing {
-		*sm.orig = append(*sm.orig, NewStringKeyValue(k, v).orig)
+// Equal returns true if a and other have the same type and hold the same
+// value, comparing nested MAP and ARRAY values recursively.
+func (a AttributeValue) Equal(other AttributeValue) bool {
+	if a.Type() != other.Type() {
+		return false
 	}
-}
-
-// Update updates an existing StringKeyValue with a value.
-// No action is applied to the map where the key does not exist.
-func (sm StringMap) Update(k, v string) {
-	if av, existing := sm.Get(k); existing {
-		av.SetValue(v)
-	}
-}
-
-// Upsert performs the Insert or Update action. The StringKeyValue is
-// insert to the map that did not originally have the key. The key/value is
-// updated to the map where the key already existed.
-func (sm StringMap) Upsert(k, v string) {
-	if av, existing := sm.Get(k); existing {
-		av.SetValue(v)
-	} else {
-		*sm.orig = append(*sm.orig, NewStringKeyValue(k, v).orig)
+	switch a.Type() {
+	case AttributeValueSTRING:
+		return a.StringVal() == other.StringVal()
+	case AttributeValueINT:
+		return a.IntVal() == other.IntVal()
+	case AttributeValueDOUBLE:
+		return a.DoubleVal() == other.DoubleVal()
+	case AttributeValueBOOL:
+		return a.BoolVal() == other.BoolVal()
+	case AttributeValueMAP:
+		return a.MapVal().Equal(other.MapVal())
+	case AttributeValueARRAY:
+		aArr, oArr := a.ArrayVal(), other.ArrayVal()
+		if aArr.Len() != oArr.Len() {
+			return false
+		}
+		for i := 0; i < aArr.Len(); i++ {
+			if !aArr.Get(i).Equal(oArr.Get(i)) {
+				return false
+			}
+		}
+		return true
 	}
+	return true
 }
 
-// Len returns the number of StringKeyValue in the map.
-func (sm StringMap) Len() int {
-	return len(*sm.orig)
-}
-
-// GetStringKeyValue returns the StringKeyValue associated with the given index.
-//
-// This function is used mostly for iterating over all the values in the map:
-// for i := 0; i < am.Len(); i++ {
-//     akv := am.GetStringKeyValue(i)
-//     ... // Do something with the attribute
-// }
-func (sm StringMap) GetStringKeyValue(ix int) StringKeyValue {
-	return StringKeyValue{(*sm.orig)[ix]}
-}
-
-// Sort sorts the entries in the StringMap so two instances can be compared.
-// Returns the same instance to allow nicer code like:
-// assert.EqualValues(t, expected.Sort(), actual.Sort())
-func (sm StringMap) Sort() StringMap {
-	sort.SliceStable(*sm.orig, func(i, j int) bool { return (*sm.orig)[i].Key < (*sm.orig)[j].Key })
-	return sm
-}
-
-// InstrumentationLibrary is a message representing the instrumentation library information.
-//
-// Must use NewResource functions to create new instances.
-// Important: zero-initialized instance is not valid for use.
-type InstrumentationLibrary struct {
-	orig *otlpcommon.InstrumentationLibrary
-}
-
-// NewInstrumentationLibrary creates a new InstrumentationLibrary.
-func NewInstrumentationLibrary() InstrumentationLibrary {
-	return InstrumentationLibrary{}
+// Attributes stores the map of attributes and a number of dropped attributes.
+// Typically used by translator functions to easily pass the pair.
+type Attributes struct {
+	attrs        AttributesMap
+	droppedCount uint32
 }
 
-func newInstrumentationLibrary(orig *otlpcommon.InstrumentationLibrary) InstrumentationLibrary {
-	return InstrumentationLibrary{orig}
+func NewAttributes(m AttributesMap, droppedCount uint32) Attributes {
+	return Attributes{m, droppedCount}
 }
 
-func (il InstrumentationLibrary) Name() string {
-	return il.orig.Name
+// CopyTo copies the attribute map and dropped count from a into dest.
+// Unlike the other CopyTo methods in this file, dest is a pointer: Attributes
+// is a plain value struct rather than a wrapper around a shared orig pointer,
+// so there is no other way for this method to mutate it.
+func (a Attributes) CopyTo(dest *Attributes) {
+	dest.attrs = a.attrs.Clone()
+	dest.droppedCount = a.droppedCount
 }
 
-func (il InstrumentationLibrary) SetName(r string) {
-	il.orig.Name = r
+// Clone returns an independent deep copy of a.
+func (a Attributes) Clone() Attributes {
+	var dest Attributes
+	a.CopyTo(&dest)
+	return dest
 }
 
-func (il InstrumentationLibrary) Version() string {
-	return il.orig.Version
+// Equal returns true if a and other have equal attribute maps and the same
+// dropped count.
+func (a Attributes) Equal(other Attributes) bool {
+	return a.droppedCount == other.droppedCount && a.attrs.Equal(other.attrs)
 }
 
-func (il InstrumentationLibrary) SetVersion(r string) {
-	il.orig.Version = r
-}
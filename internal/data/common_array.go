@@ -0,0 +1,62 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	otlpcommon "github.com/open-telemetry/opentelemetry-proto/gen/go/common/v1"
+)
+
+// AnyValueArray is an ordered list of AttributeValue. Used to represent the
+// value of an AttributeValueARRAY.
+//
+// Must use NewAnyValueArray function to create new instances.
+// Important: zero-initialized instance is not valid for use.
+type AnyValueArray struct {
+	orig *[]otlpcommon.AttributeKeyValue
+}
+
+func newAnyValueArray(orig *[]otlpcommon.AttributeKeyValue) AnyValueArray {
+	return AnyValueArray{orig}
+}
+
+// NewAnyValueArray creates an AnyValueArray with the given AttributeValues.
+func NewAnyValueArray(values []AttributeValue) AnyValueArray {
+	orig := make([]otlpcommon.AttributeKeyValue, len(values))
+	for i, v := range values {
+		orig[i] = *v.orig
+	}
+	return AnyValueArray{&orig}
+}
+
+// Len returns the number of AttributeValues in the array.
+func (es AnyValueArray) Len() int {
+	return len(*es.orig)
+}
+
+// Get returns the AttributeValue at the given index.
+//
+// This function is used mostly for iterating over all the values in the array:
+// for i := 0; i < es.Len(); i++ {
+//     e := es.Get(i)
+//     ... // Do something with the element
+// }
+func (es AnyValueArray) Get(ix int) AttributeValue {
+	return AttributeValue{orig: &(*es.orig)[ix]}
+}
+
+// Append adds a new AttributeValue to the end of the array.
+func (es AnyValueArray) Append(v AttributeValue) {
+	*es.orig = append(*es.orig, *v.orig)
+}
@@ -0,0 +1,228 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+// This file holds the hand-maintained additions to the types cmd/pdatagen
+// generates into generated_common.go: the Get/Delete/Insert/Upsert/Sort
+// map-like lookup methods on StringMap and AttributesMap (which the generic
+// slice codegen has no way to express), NewStringMapFromRaw for building a
+// StringMap from literal data, and the Clone/Equal helpers that exist on
+// every attribute container.
+
+import "sort"
+
+// Clone returns an independent deep copy of am.
+func (am AttributesMap) Clone() AttributesMap {
+	dest := NewAttributesMap()
+	am.CopyTo(dest)
+	return dest
+}
+
+// Equal returns true if am and other hold the same set of (key, value)
+// pairs, regardless of any difference in order.
+func (am AttributesMap) Equal(other AttributesMap) bool {
+	if am.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < am.Len(); i++ {
+		v := am.At(i)
+		ov, ok := other.Get(v.Key())
+		if !ok || !v.Equal(ov) {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the AttributeValue associated with the key and true,
+// otherwise an invalid instance of the AttributeValue and false.
+func (am AttributesMap) Get(k string) (AttributeValue, bool) {
+	for i := 0; i < am.Len(); i++ {
+		v := am.At(i)
+		if v.Key() == k {
+			return v, true
+		}
+	}
+	return AttributeValue{}, false
+}
+
+// Delete deletes the entry associated with the key and returns true if the key
+// was present in the map, otherwise returns false.
+func (am AttributesMap) Delete(k string) bool {
+	for i := range *am.orig {
+		if (*am.orig)[i].Key == k {
+			(*am.orig)[i] = (*am.orig)[len(*am.orig)-1]
+			*am.orig = (*am.orig)[:len(*am.orig)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// Insert adds v to the map under k when the key does not already exist.
+// No action is applied to the map where the key already exists.
+func (am AttributesMap) Insert(k string, v AttributeValue) {
+	if _, existing := am.Get(k); !existing {
+		v.SetKey(k)
+		am.Append(v)
+	}
+}
+
+// Update updates an existing entry's value, leaving its key untouched.
+// No action is applied to the map where the key does not exist.
+func (am AttributesMap) Update(k string, v AttributeValue) {
+	if existing, ok := am.Get(k); ok {
+		key := existing.Key()
+		v.CopyTo(existing)
+		existing.SetKey(key)
+	}
+}
+
+// Upsert performs the Insert or Update action: v is inserted under k when
+// the key does not already exist, and overwrites the existing value in
+// place (keeping the key) when it does.
+func (am AttributesMap) Upsert(k string, v AttributeValue) {
+	if existing, ok := am.Get(k); ok {
+		key := existing.Key()
+		v.CopyTo(existing)
+		existing.SetKey(key)
+	} else {
+		am.Insert(k, v)
+	}
+}
+
+// Sort sorts the entries in the AttributesMap so two instances can be
+// compared. Returns the same instance to allow nicer code like:
+// assert.EqualValues(t, expected.Sort(), actual.Sort())
+func (am AttributesMap) Sort() AttributesMap {
+	sort.SliceStable(*am.orig, func(i, j int) bool { return (*am.orig)[i].Key < (*am.orig)[j].Key })
+	return am
+}
+
+// NewStringMapFromRaw creates a StringMap from a raw map[string]string. The
+// generated NewStringMap is always zero-arg, matching every other slice
+// wrapper's New<Type>() convention, so this is the convenience entry point
+// for building one from literal data in hand, e.g. in tests and config
+// parsing, that NewStringMap(map[string]string) used to serve.
+func NewStringMapFromRaw(rawMap map[string]string) StringMap {
+	sm := NewStringMap()
+	sm.Resize(len(rawMap))
+	ix := 0
+	for k, v := range rawMap {
+		akv := sm.At(ix)
+		akv.SetKey(k)
+		akv.SetValue(v)
+		ix++
+	}
+	return sm
+}
+
+// Get returns the StringKeyValue associated with the key and true,
+// otherwise an invalid instance of the StringKeyValue and false.
+func (sm StringMap) Get(k string) (StringKeyValue, bool) {
+	for i := 0; i < sm.Len(); i++ {
+		akv := sm.At(i)
+		if akv.Key() == k {
+			return akv, true
+		}
+	}
+	return StringKeyValue{}, false
+}
+
+// Delete deletes the entry associated with the key and returns true if the key
+// was present in the map, otherwise returns false.
+func (sm StringMap) Delete(k string) bool {
+	for i := range *sm.orig {
+		if (*sm.orig)[i].Key == k {
+			(*sm.orig)[i] = (*sm.orig)[len(*sm.orig)-1]
+			*sm.orig = (*sm.orig)[:len(*sm.orig)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// Insert adds the StringKeyValue to the map when the key does not exist.
+// No action is applied to the map where the key already exists.
+func (sm StringMap) Insert(k, v string) {
+	if _, existing := sm.Get(k); !existing {
+		akv := NewStringKeyValue()
+		akv.SetKey(k)
+		akv.SetValue(v)
+		sm.Append(akv)
+	}
+}
+
+// Update updates an existing StringKeyValue with a value.
+// No action is applied to the map where the key does not exist.
+func (sm StringMap) Update(k, v string) {
+	if akv, existing := sm.Get(k); existing {
+		akv.SetValue(v)
+	}
+}
+
+// Upsert performs the Insert or Update action. The StringKeyValue is
+// insert to the map that did not originally have the key. The key/value is
+// updated to the map where the key already existed.
+func (sm StringMap) Upsert(k, v string) {
+	if akv, existing := sm.Get(k); existing {
+		akv.SetValue(v)
+	} else {
+		sm.Insert(k, v)
+	}
+}
+
+// Sort sorts the entries in the StringMap so two instances can be compared.
+// Returns the same instance to allow nicer code like:
+// assert.EqualValues(t, expected.Sort(), actual.Sort())
+func (sm StringMap) Sort() StringMap {
+	sort.SliceStable(*sm.orig, func(i, j int) bool { return (*sm.orig)[i].Key < (*sm.orig)[j].Key })
+	return sm
+}
+
+// Clone returns an independent deep copy of sm.
+func (sm StringMap) Clone() StringMap {
+	dest := NewStringMap()
+	sm.CopyTo(dest)
+	return dest
+}
+
+// Equal returns true if sm and other hold the same (key, value) pairs,
+// regardless of any difference in order.
+func (sm StringMap) Equal(other StringMap) bool {
+	if sm.Len() != other.Len() {
+		return false
+	}
+	for i := 0; i < sm.Len(); i++ {
+		kv := sm.At(i)
+		ov, ok := other.Get(kv.Key())
+		if !ok || ov.Value() != kv.Value() {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns an independent deep copy of il.
+func (il InstrumentationLibrary) Clone() InstrumentationLibrary {
+	dest := NewInstrumentationLibrary()
+	il.CopyTo(dest)
+	return dest
+}
+
+// Equal returns true if il and other have the same name and version.
+func (il InstrumentationLibrary) Equal(other InstrumentationLibrary) bool {
+	return il.Name() == other.Name() && il.Version() == other.Version()
+}
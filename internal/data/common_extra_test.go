@@ -0,0 +1,50 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStringMapFromRaw(t *testing.T) {
+	sm := NewStringMapFromRaw(map[string]string{"k1": "v1", "k2": "v2"})
+
+	assert.EqualValues(t, 2, sm.Len())
+	v, ok := sm.Get("k1")
+	assert.True(t, ok)
+	assert.Equal(t, "v1", v.Value())
+	v, ok = sm.Get("k2")
+	assert.True(t, ok)
+	assert.Equal(t, "v2", v.Value())
+}
+
+func TestAttributesMap_CopyToClearsStaleKeys(t *testing.T) {
+	dest := NewAttributesMap()
+	dest.Insert("stale", NewAttributeValueString("old"))
+
+	src := NewAttributesMap()
+	src.Insert("a", NewAttributeValueString("new"))
+
+	src.CopyTo(dest)
+
+	assert.EqualValues(t, 1, dest.Len())
+	v, ok := dest.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "new", v.StringVal())
+	_, staleStillPresent := dest.Get("stale")
+	assert.False(t, staleStillPresent)
+}
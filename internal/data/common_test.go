@@ -0,0 +1,165 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributeValue_MapValWriteThrough(t *testing.T) {
+	attr := NewAttributeValueMap(NewAttributesMap())
+
+	attr.MapVal().Insert("new", NewAttributeValueString("x"))
+
+	v, ok := attr.MapVal().Get("new")
+	assert.True(t, ok)
+	assert.Equal(t, "x", v.StringVal())
+
+	assert.True(t, attr.MapVal().Delete("new"))
+	_, ok = attr.MapVal().Get("new")
+	assert.False(t, ok)
+}
+
+func TestNewAttributeValueMap(t *testing.T) {
+	m := NewAttributesMap()
+	m.Insert("k", NewAttributeValueString("v"))
+
+	attr := NewAttributeValueMap(m)
+
+	assert.Equal(t, AttributeValueMAP, attr.Type())
+	v, ok := attr.MapVal().Get("k")
+	assert.True(t, ok)
+	assert.Equal(t, "v", v.StringVal())
+}
+
+func TestNewAttributeValueArray(t *testing.T) {
+	arr := NewAnyValueArray([]AttributeValue{NewAttributeValueInt(1), NewAttributeValueInt(2)})
+
+	attr := NewAttributeValueArray(arr)
+
+	assert.Equal(t, AttributeValueARRAY, attr.Type())
+	assert.EqualValues(t, 2, attr.ArrayVal().Len())
+	assert.EqualValues(t, 1, attr.ArrayVal().Get(0).IntVal())
+	assert.EqualValues(t, 2, attr.ArrayVal().Get(1).IntVal())
+}
+
+func TestAttributeValue_ArrayValWriteThrough(t *testing.T) {
+	attr := NewAttributeValueArray(NewAnyValueArray(nil))
+
+	attr.ArrayVal().Append(NewAttributeValueInt(42))
+
+	assert.EqualValues(t, 1, attr.ArrayVal().Len())
+	assert.EqualValues(t, 42, attr.ArrayVal().Get(0).IntVal())
+}
+
+func TestAttributeValue_CopyToCloneEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		src  AttributeValue
+	}{
+		{"string", NewAttributeValueString("a string")},
+		{"int", NewAttributeValueInt(123)},
+		{"double", NewAttributeValueDouble(1.5)},
+		{"bool", NewAttributeValueBool(true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clone := tt.src.Clone()
+			assert.True(t, tt.src.Equal(clone))
+
+			dest := NewAttributeValueString("")
+			tt.src.CopyTo(dest)
+			assert.True(t, tt.src.Equal(dest))
+		})
+	}
+}
+
+func TestAttributeValue_CopyToCloneEqualNestedMap(t *testing.T) {
+	m := NewAttributesMap()
+	m.Insert("k", NewAttributeValueString("v"))
+	src := NewAttributeValueMap(m)
+
+	clone := src.Clone()
+	assert.True(t, src.Equal(clone))
+
+	// Mutating the clone's nested map must not affect the original.
+	clone.MapVal().Insert("other", NewAttributeValueString("x"))
+	assert.False(t, src.Equal(clone))
+
+	dest := NewAttributeValueString("")
+	src.CopyTo(dest)
+	assert.True(t, src.Equal(dest))
+}
+
+func TestAttributeValue_CopyToCloneEqualNestedArray(t *testing.T) {
+	src := NewAttributeValueArray(NewAnyValueArray([]AttributeValue{NewAttributeValueInt(1), NewAttributeValueInt(2)}))
+
+	clone := src.Clone()
+	assert.True(t, src.Equal(clone))
+
+	// Mutating the clone's nested array must not affect the original.
+	clone.ArrayVal().Append(NewAttributeValueInt(3))
+	assert.False(t, src.Equal(clone))
+
+	dest := NewAttributeValueString("")
+	src.CopyTo(dest)
+	assert.True(t, src.Equal(dest))
+}
+
+func TestAttributeValue_EqualDifferentType(t *testing.T) {
+	assert.False(t, NewAttributeValueInt(1).Equal(NewAttributeValueString("1")))
+}
+
+func TestAttributes_CopyToCloneEqual(t *testing.T) {
+	m := NewAttributesMap()
+	m.Insert("k", NewAttributeValueString("v"))
+	src := NewAttributes(m, 3)
+
+	clone := src.Clone()
+	assert.True(t, src.Equal(clone))
+	assert.EqualValues(t, 3, clone.droppedCount)
+
+	// Mutating the clone's attribute map must not affect the original.
+	clone.attrs.Insert("other", NewAttributeValueString("x"))
+	assert.False(t, src.Equal(clone))
+
+	var dest Attributes
+	src.CopyTo(&dest)
+	assert.True(t, src.Equal(dest))
+}
+
+func TestStringMap_CloneEqual(t *testing.T) {
+	src := NewStringMapFromRaw(map[string]string{"k1": "v1", "k2": "v2"})
+
+	clone := src.Clone()
+	assert.True(t, src.Equal(clone))
+
+	clone.Insert("k3", "v3")
+	assert.False(t, src.Equal(clone))
+}
+
+func TestInstrumentationLibrary_CloneEqual(t *testing.T) {
+	src := NewInstrumentationLibrary()
+	src.SetName("test_name")
+	src.SetVersion("test_version")
+
+	clone := src.Clone()
+	assert.True(t, src.Equal(clone))
+
+	clone.SetVersion("other_version")
+	assert.False(t, src.Equal(clone))
+}